@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs"
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs/cluster"
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs/cluster/clusterpb"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	peersFlag = &cli.StringFlag{
+		Name:  "peers",
+		Usage: "其他 peer 的 gRPC 地址，逗号分隔，如 127.0.0.1:7901,127.0.0.1:7902",
+	}
+	listenFlag = &cli.StringFlag{
+		Name:  "listen",
+		Usage: "本 peer 的 gRPC 监听地址",
+		Value: "127.0.0.1:7900",
+	}
+	raftDirFlag = &cli.StringFlag{
+		Name:  "raft-dir",
+		Usage: "本 peer 的 raft 快照目录",
+		Value: "zgs-raft",
+	}
+)
+
+var clusterCommand = &cli.Command{
+	Name:  "cluster",
+	Usage: "多个 zgs 进程协作上传一个大文件",
+	Subcommands: []*cli.Command{
+		clusterRunCommand,
+		clusterStatusCommand,
+	},
+}
+
+var clusterRunCommand = &cli.Command{
+	Name:      "run",
+	Usage:     "启动本 peer 并（若没有其他 peer 或自己就是 leader）上传文件",
+	ArgsUsage: "<path>",
+	Flags:     []cli.Flag{peersFlag, listenFlag, raftDirFlag},
+	Action:    clusterRunAction,
+}
+
+var clusterStatusCommand = &cli.Command{
+	Name:      "status",
+	Usage:     "打印 leader、peer 集合、各 peer 分片进度和 shard 分配",
+	ArgsUsage: "<peer-address>",
+	Action:    clusterStatusAction,
+}
+
+func splitPeers(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+	var peers []string
+	for _, p := range strings.Split(raw, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			peers = append(peers, p)
+		}
+	}
+	return peers
+}
+
+func clusterRunAction(c *cli.Context) error {
+	path := c.Args().First()
+	if path == "" {
+		return cli.Exit("用法: zgs cluster run --listen <addr> --peers <a,b,...> <path>", 1)
+	}
+
+	client, err := newClient(c)
+	if err != nil {
+		return err
+	}
+
+	listenAddr := c.String(listenFlag.Name)
+	peerAddrs := splitPeers(c.String(peersFlag.Name))
+
+	cfg, err := configFromContext(c)
+	if err != nil {
+		return err
+	}
+	nodes, err := zgs.QueryShardedNodes(c.Context, cfg)
+	if err != nil {
+		return fmt.Errorf("查询 shard 拓扑失败: %w", err)
+	}
+	numShard := 1
+	if len(nodes.Trusted) > 0 {
+		numShard = int(nodes.Trusted[0].Config.NumShard)
+	}
+
+	peers := []cluster.PeerInfo{{ID: listenAddr, Address: listenAddr, NumShard: numShard, ShardID: 0}}
+	for i, addr := range peerAddrs {
+		peers = append(peers, cluster.PeerInfo{ID: addr, Address: addr, NumShard: numShard, ShardID: (i + 1) % numShard})
+	}
+
+	node, err := cluster.New(cluster.Config{
+		ListenAddr: listenAddr,
+		Peers:      peerAddrs,
+		RaftDir:    c.String(raftDirFlag.Name),
+		Client:     client,
+		InputPath:  path,
+	}, len(peerAddrs) == 0)
+	if err != nil {
+		return fmt.Errorf("启动集群节点失败: %w", err)
+	}
+
+	// 刚 bootstrap 的单 peer 集群要等 raft 选举超时后才会成为 leader，
+	// 这里最多等 5 秒再判断自己的角色。
+	if len(peerAddrs) == 0 {
+		for i := 0; i < 50 && !node.IsLeader(); i++ {
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+
+	if !node.IsLeader() {
+		fmt.Println("✅ 已作为 follower 启动，等待 leader 分发分片...")
+		select {}
+	}
+
+	root, err := node.UploadFile(c.Context, path, cfg.ChunkSize, peers)
+	if err != nil {
+		return fmt.Errorf("集群上传失败: %w", err)
+	}
+
+	fmt.Printf("✅ 集群上传成功！Manifest Root: %s\n", root)
+	return nil
+}
+
+func clusterStatusAction(c *cli.Context) error {
+	addr := c.Args().First()
+	if addr == "" {
+		return cli.Exit("用法: zgs cluster status <peer-address>", 1)
+	}
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("连接 peer 失败: %w", err)
+	}
+	defer conn.Close()
+
+	status, err := clusterpb.NewClusterServiceClient(conn).GetStatus(context.Background(), &clusterpb.StatusRequest{})
+	if err != nil {
+		return fmt.Errorf("调用 GetStatus 失败: %w", err)
+	}
+
+	fmt.Printf("Leader: %s\n", status.LeaderId)
+	fmt.Fprintln(os.Stdout, "Peers:")
+	for _, p := range status.Peers {
+		fmt.Printf("  #%s Address=%s NumShard=%d ShardId=%d 进度=%d/%d\n",
+			p.PeerId, p.Address, p.NumShard, p.ShardId, p.ChunksDone, p.ChunksAssigned)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+)
+
+var downloadCommand = &cli.Command{
+	Name:      "download",
+	Usage:     "按 manifest root 下载并重新拼装文件",
+	ArgsUsage: "<root> <out>",
+	Action:    downloadAction,
+}
+
+func downloadAction(c *cli.Context) error {
+	if c.Args().Len() < 2 {
+		return cli.Exit("用法: zgs download <root> <out>", 1)
+	}
+	root := c.Args().Get(0)
+	out := c.Args().Get(1)
+
+	client, err := newClient(c)
+	if err != nil {
+		return err
+	}
+
+	if err := client.DownloadFile(c.Context, root, out); err != nil {
+		return fmt.Errorf("下载失败: %w", err)
+	}
+
+	fmt.Printf("✅ 下载成功: %s\n", out)
+	return nil
+}
@@ -0,0 +1,46 @@
+package main
+
+import "github.com/urfave/cli/v2"
+
+// 全局 flag，替代旧 main.go 里的 const 配置区域。
+// 默认值留空，由 configFromContext 去套用 zgs.DefaultConfig()，
+// 这样「没传 flag」和「传了与默认值相同的 flag」可以区分开。
+var (
+	configFileFlag = &cli.StringFlag{
+		Name:    "config",
+		Aliases: []string{"c"},
+		Usage:   "加载 TOML 配置文件，字段见 zgs.Config",
+	}
+	rpcFlag = &cli.StringFlag{
+		Name:  "rpc",
+		Usage: "0G EVM JSON-RPC 地址",
+	}
+	indexerFlag = &cli.StringFlag{
+		Name:  "indexer",
+		Usage: "0G Storage indexer 地址",
+	}
+	flowFlag = &cli.StringFlag{
+		Name:  "flow",
+		Usage: "ZeroGStorage flow 合约地址 (0x...)",
+	}
+	taskSizeFlag = &cli.IntFlag{
+		Name:  "task-size",
+		Usage: "单次上传任务包含的 segment 数量 (fragment size)",
+	}
+	replicaFlag = &cli.UintFlag{
+		Name:  "replica",
+		Usage: "期望的副本数 (ExpectedReplica)",
+	}
+	keyEnvFlag = &cli.StringFlag{
+		Name:  "key-env",
+		Usage: "存放私钥的环境变量名",
+	}
+	concurrencyFlag = &cli.IntFlag{
+		Name:  "concurrency",
+		Usage: "上传/下载分片的并发 worker 数量",
+	}
+	mirrorFlag = &cli.StringFlag{
+		Name:  "mirror",
+		Usage: "可选的镜像后端地址，如 file:///path、s3://bucket/prefix、gs://bucket/prefix",
+	}
+)
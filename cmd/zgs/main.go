@@ -0,0 +1,115 @@
+// Command zgs 是 0G Storage 的命令行客户端，提供 upload / download /
+// nodes / verify 等子命令，替代早期硬编码在 main.go 里的演示脚本。
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs"
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs/mirror"
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs/pipeline"
+	"github.com/urfave/cli/v2"
+)
+
+func main() {
+	app := &cli.App{
+		Name:  "zgs",
+		Usage: "0G Storage 命令行工具",
+		Flags: []cli.Flag{
+			configFileFlag,
+			rpcFlag,
+			indexerFlag,
+			flowFlag,
+			taskSizeFlag,
+			replicaFlag,
+			keyEnvFlag,
+			concurrencyFlag,
+			mirrorFlag,
+		},
+		Commands: []*cli.Command{
+			uploadCommand,
+			downloadCommand,
+			nodesCommand,
+			verifyCommand,
+			syncCommand,
+			clusterCommand,
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// configFromContext 按照 geth `makeConfigNode` 的套路，先取默认值，
+// 再用 --config 指定的文件覆盖，最后用命令行 flag 覆盖文件内容。
+func configFromContext(c *cli.Context) (zgs.Config, error) {
+	cfg := zgs.DefaultConfig()
+
+	if path := c.String(configFileFlag.Name); path != "" {
+		loaded, err := zgs.LoadConfigFile(path, cfg)
+		if err != nil {
+			return cfg, err
+		}
+		cfg = loaded
+	}
+
+	if c.IsSet(rpcFlag.Name) {
+		cfg.RpcUrl = c.String(rpcFlag.Name)
+	}
+	if c.IsSet(indexerFlag.Name) {
+		cfg.IndexerUrl = c.String(indexerFlag.Name)
+	}
+	if c.IsSet(flowFlag.Name) {
+		cfg.FlowAddress = c.String(flowFlag.Name)
+	}
+	if c.IsSet(taskSizeFlag.Name) {
+		cfg.TaskSize = c.Int(taskSizeFlag.Name)
+	}
+	if c.IsSet(replicaFlag.Name) {
+		cfg.Replica = uint(c.Uint(replicaFlag.Name))
+	}
+	if c.IsSet(keyEnvFlag.Name) {
+		cfg.KeyEnv = c.String(keyEnvFlag.Name)
+	}
+	if c.IsSet(concurrencyFlag.Name) {
+		cfg.Concurrency = c.Int(concurrencyFlag.Name)
+	}
+	if c.IsSet(mirrorFlag.Name) {
+		cfg.MirrorURL = c.String(mirrorFlag.Name)
+	}
+
+	return cfg, nil
+}
+
+// newClient 从全局 flag / 配置文件构造一个 zgs.StorageClient，
+// 私钥从 cfg.KeyEnv 指定的环境变量读取。
+func newClient(c *cli.Context) (*zgs.StorageClient, error) {
+	cfg, err := configFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyHex := os.Getenv(cfg.KeyEnv)
+	if privateKeyHex == "" {
+		return nil, fmt.Errorf("请先设置环境变量 %s=0x...", cfg.KeyEnv)
+	}
+
+	client, err := zgs.NewStorageClient(cfg, privateKeyHex)
+	if err != nil {
+		return nil, err
+	}
+	client = client.WithProgress(pipeline.NewConsoleProgress(os.Stderr))
+
+	if cfg.MirrorURL != "" {
+		backend, err := mirror.ParseURL(c.Context, cfg.MirrorURL)
+		if err != nil {
+			return nil, err
+		}
+		client = client.WithMirror(backend)
+	}
+
+	return client, nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/0glabs/0g-storage-client/common/shard"
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs"
+	"github.com/urfave/cli/v2"
+)
+
+var nodesCommand = &cli.Command{
+	Name:   "nodes",
+	Usage:  "打印 indexer 返回的节点和 shard 配置，辅助排查副本/分片问题",
+	Action: nodesAction,
+}
+
+// nodesAction 不需要私钥，只查询 indexer，对应旧版 debugShardedNodes。
+func nodesAction(c *cli.Context) error {
+	cfg, err := configFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	nodes, err := zgs.QueryShardedNodes(c.Context, cfg)
+	if err != nil {
+		return fmt.Errorf("调用 GetShardedNodes 失败: %w", err)
+	}
+
+	fmt.Printf("Indexer 返回节点情况：Trusted=%d, Discovered=%d\n", len(nodes.Trusted), len(nodes.Discovered))
+	printNodes("  Trusted 节点列表：", nodes.Trusted)
+	printNodes("  Discovered 节点列表：", nodes.Discovered)
+	return nil
+}
+
+func printNodes(title string, list []*shard.ShardedNode) {
+	fmt.Println(title)
+	for i, n := range list {
+		fmt.Printf("  #%d URL=%s, NumShard=%d, ShardId=%d, Latency=%dms\n",
+			i, n.URL, n.Config.NumShard, n.Config.ShardId, n.Latency)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs/mirror"
+	"github.com/urfave/cli/v2"
+)
+
+var syncCommand = &cli.Command{
+	Name:      "sync",
+	Usage:     "遍历一个镜像后端，把 0G 上已经缺失的分片重新上传回去",
+	ArgsUsage: "<mirror-url>",
+	Action:    syncAction,
+}
+
+func syncAction(c *cli.Context) error {
+	raw := c.Args().First()
+	if raw == "" {
+		return cli.Exit("用法: zgs sync <mirror-url>", 1)
+	}
+
+	backend, err := mirror.ParseURL(c.Context, raw)
+	if err != nil {
+		return err
+	}
+	walker, ok := backend.(mirror.Walker)
+	if !ok {
+		return cli.Exit(fmt.Sprintf("mirror 后端 %s 不支持遍历", raw), 1)
+	}
+
+	client, err := newClient(c)
+	if err != nil {
+		return err
+	}
+
+	return walker.Walk(c.Context, func(key string) error {
+		if strings.HasPrefix(key, "manifests/") {
+			// 人类可读前缀下的 manifest 副本本身也是一个 zg root，
+			// 但不应该被当作普通分片重传，跳过。
+			return nil
+		}
+
+		if err := client.Verify(c.Context, key, os.DevNull); err == nil {
+			fmt.Printf("✓ %s 已存在于 0G\n", key)
+			return nil
+		}
+
+		rc, err := backend.Get(c.Context, key)
+		if err != nil {
+			return fmt.Errorf("读取 mirror 分片 %s 失败: %w", key, err)
+		}
+		defer rc.Close()
+
+		data, err := io.ReadAll(rc)
+		if err != nil {
+			return fmt.Errorf("读取 mirror 分片 %s 失败: %w", key, err)
+		}
+
+		txHash, root, err := client.Upload(c.Context, data)
+		if err != nil {
+			return fmt.Errorf("重新上传分片 %s 失败: %w", key, err)
+		}
+
+		fmt.Printf("↺ %s 缺失，已重新上传。Root=%s TxHash=%s\n", key, root, txHash)
+		return nil
+	})
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+)
+
+var uploadCommand = &cli.Command{
+	Name:      "upload",
+	Usage:     "将文件切片上传，返回一个可用于下载整个文件的 manifest root",
+	ArgsUsage: "<path>",
+	Action:    uploadAction,
+}
+
+func uploadAction(c *cli.Context) error {
+	path := c.Args().First()
+	if path == "" {
+		return cli.Exit("用法: zgs upload <path>", 1)
+	}
+
+	client, err := newClient(c)
+	if err != nil {
+		return err
+	}
+
+	root, err := client.UploadFile(c.Context, path)
+	if err != nil {
+		return fmt.Errorf("上传失败: %w", err)
+	}
+
+	fmt.Printf("✅ 上传成功！Manifest Root: %s\n", root)
+	return nil
+}
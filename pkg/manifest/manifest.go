@@ -0,0 +1,63 @@
+// Package manifest implements a small Merkle-DAG-style manifest object that
+// ties the many chunk roots of one logically-split file back together under
+// a single top-level root, borrowing the idea from Git trees / IPFS DAGs.
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Chunk describes one slice of the original file and the root it was
+// uploaded under.
+type Chunk struct {
+	Index  int    `json:"index"`
+	Offset int64  `json:"offset"`
+	Length int64  `json:"length"`
+	SHA256 string `json:"sha256"`
+	ZgRoot string `json:"zg_root"`
+}
+
+// Manifest is the DAG "tree" object: it doesn't hold any chunk data itself,
+// only the metadata needed to fetch and reassemble it.
+type Manifest struct {
+	Filename  string  `json:"filename"`
+	TotalSize int64   `json:"total_size"`
+	ChunkSize int64   `json:"chunk_size"`
+	Chunks    []Chunk `json:"chunks"`
+}
+
+// New creates an empty manifest for a file of the given size, split into
+// chunks of at most chunkSize bytes.
+func New(filename string, totalSize, chunkSize int64) *Manifest {
+	return &Manifest{
+		Filename:  filename,
+		TotalSize: totalSize,
+		ChunkSize: chunkSize,
+	}
+}
+
+// AddChunk records one uploaded chunk, in order.
+func (m *Manifest) AddChunk(c Chunk) {
+	m.Chunks = append(m.Chunks, c)
+}
+
+// Encode canonically serializes the manifest. Struct field order is fixed
+// by the type definition, so two manifests built from the same chunk list
+// always encode to the same bytes.
+func (m *Manifest) Encode() ([]byte, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("编码 manifest 失败: %w", err)
+	}
+	return data, nil
+}
+
+// Decode parses a manifest previously produced by Encode.
+func Decode(data []byte) (*Manifest, error) {
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("解析 manifest 失败: %w", err)
+	}
+	return &m, nil
+}
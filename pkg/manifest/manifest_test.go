@@ -0,0 +1,38 @@
+package manifest
+
+import "testing"
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	m := New("big.bin", 3*1024, 1024)
+	m.AddChunk(Chunk{Index: 0, Offset: 0, Length: 1024, SHA256: "aaa", ZgRoot: "root-0"})
+	m.AddChunk(Chunk{Index: 1, Offset: 1024, Length: 1024, SHA256: "bbb", ZgRoot: "root-1"})
+	m.AddChunk(Chunk{Index: 2, Offset: 2048, Length: 1024, SHA256: "ccc", ZgRoot: "root-2"})
+
+	encoded, err := m.Encode()
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	got, err := Decode(encoded)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	if got.Filename != m.Filename || got.TotalSize != m.TotalSize || got.ChunkSize != m.ChunkSize {
+		t.Fatalf("Decode() header = %+v, want %+v", got, m)
+	}
+	if len(got.Chunks) != len(m.Chunks) {
+		t.Fatalf("Decode() got %d chunks, want %d", len(got.Chunks), len(m.Chunks))
+	}
+	for i := range m.Chunks {
+		if got.Chunks[i] != m.Chunks[i] {
+			t.Errorf("chunk %d = %+v, want %+v", i, got.Chunks[i], m.Chunks[i])
+		}
+	}
+}
+
+func TestDecodeInvalidJSON(t *testing.T) {
+	if _, err := Decode([]byte("not json")); err == nil {
+		t.Fatal("Decode() with invalid JSON: got nil error, want non-nil")
+	}
+}
@@ -0,0 +1,130 @@
+// Package zgs 封装了对 0G Storage 的上传 / 下载操作，供 cmd/zgs CLI
+// 以及第三方程序共同复用。
+package zgs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	zg_common "github.com/0glabs/0g-storage-client/common"
+	"github.com/0glabs/0g-storage-client/common/blockchain"
+	"github.com/0glabs/0g-storage-client/contract"
+	"github.com/0glabs/0g-storage-client/core"
+	"github.com/0glabs/0g-storage-client/indexer"
+	"github.com/0glabs/0g-storage-client/transfer"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs/mirror"
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs/pipeline"
+)
+
+// StorageClient 是对 indexer + blockchain 客户端的一层简单封装，
+// 提供 Upload / Download 能力。
+type StorageClient struct {
+	cfg      Config
+	idx      *indexer.Client
+	flow     *contract.FlowContract
+	progress pipeline.Progress
+	mirror   mirror.Backend
+}
+
+// NewStorageClient 使用给定的私钥和配置初始化一个 StorageClient。
+func NewStorageClient(cfg Config, privateKeyHex string) (*StorageClient, error) {
+	if cfg.FlowAddress == "" {
+		return nil, fmt.Errorf("请通过 --flow 或配置文件指定 flow 合约地址")
+	}
+
+	w3 := blockchain.MustNewWeb3(cfg.RpcUrl, privateKeyHex)
+
+	flow, err := contract.NewFlowContract(eth_common.HexToAddress(cfg.FlowAddress), w3)
+	if err != nil {
+		return nil, fmt.Errorf("创建 flow 合约客户端失败: %w", err)
+	}
+
+	idxClient, err := indexer.NewClient(cfg.IndexerUrl, indexer.IndexerClientOption{
+		LogOption: zg_common.LogOption{
+			LogLevel: logrus.InfoLevel, // 避免 Reminder 使用 PanicLevel 导致 panic
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("indexer 客户端初始化失败: %w", err)
+	}
+
+	return &StorageClient{
+		cfg:  cfg,
+		idx:  idxClient,
+		flow: flow,
+	}, nil
+}
+
+// WithMirror 注册一个 mirror.Backend：此后每个成功上传的分片都会额外
+// 以其 zg root 为 key 写入这个镜像后端，作为链下缓存。
+func (c *StorageClient) WithMirror(b mirror.Backend) *StorageClient {
+	c.mirror = b
+	return c
+}
+
+// Upload 上传一块数据到 0g 存储，返回（暂不可用的）交易哈希占位和 root。
+//
+// 这一版 indexer.Client.Upload 只返回 error，不暴露交易哈希，所以第一个
+// 返回值始终是空字符串；保留它是为了不动调用方（checkpoint/manifest）的
+// 签名，TxHash 字段因此总是留空。
+func (c *StorageClient) Upload(ctx context.Context, data []byte) (string, string, error) {
+	iter, err := core.NewDataInMemory(data)
+	if err != nil {
+		return "", "", fmt.Errorf("创建内存数据失败: %w", err)
+	}
+
+	if err := c.idx.Upload(ctx, c.flow, iter, transfer.UploadOption{
+		FinalityRequired: true,
+		ExpectedReplica:  c.cfg.Replica,
+		TaskSize:         uint(c.cfg.TaskSize),
+	}); err != nil {
+		return "", "", fmt.Errorf("上传失败: %w", err)
+	}
+
+	tree, err := core.MerkleTree(iter)
+	if err != nil {
+		return "", "", fmt.Errorf("计算 Merkle Root 失败: %w", err)
+	}
+	root := tree.Root().Hex()
+
+	if c.mirror != nil {
+		if err := c.mirror.Put(ctx, root, bytes.NewReader(data), nil); err != nil {
+			return "", "", fmt.Errorf("写入 mirror 失败: %w", err)
+		}
+	}
+
+	return "", root, nil
+}
+
+// Download 按 root 下载到指定文件。
+func (c *StorageClient) Download(ctx context.Context, root, outFile string) error {
+	return c.idx.Download(ctx, root, outFile, false)
+}
+
+// Verify 重新下载 root 到一个临时位置并确认下载不报错，`zgs sync` 用它判断
+// 一个分片是否仍存在于 0G。
+//
+// 目前复用 indexer 自带的校验逻辑（Download 内部会校验 Merkle 证明），
+// 这里只是提供一个不需要保留文件的便捷入口。
+func (c *StorageClient) Verify(ctx context.Context, root, scratchPath string) error {
+	return c.idx.Download(ctx, root, scratchPath, false)
+}
+
+// ShardedNodes 返回当前 indexer 看到的 trusted / discovered 节点分布。
+// `zgs nodes` 不走这里，而是用不需要私钥的 QueryShardedNodes。
+func (c *StorageClient) ShardedNodes(ctx context.Context) (indexer.ShardedNodes, error) {
+	return c.idx.GetShardedNodes(ctx)
+}
+
+// QueryShardedNodes 只查询 indexer 的节点/分片信息，不需要私钥，
+// 供 `zgs nodes` 在没有配置签名私钥时也能使用（对应旧版 debugShardedNodes）。
+func QueryShardedNodes(ctx context.Context, cfg Config) (indexer.ShardedNodes, error) {
+	idxClient, err := indexer.NewClient(cfg.IndexerUrl)
+	if err != nil {
+		return indexer.ShardedNodes{}, fmt.Errorf("获取 Indexer 客户端失败: %w", err)
+	}
+	return idxClient.GetShardedNodes(ctx)
+}
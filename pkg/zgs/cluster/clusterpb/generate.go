@@ -0,0 +1,6 @@
+// Package clusterpb holds the generated protobuf/gRPC code for
+// proto/cluster/cluster.proto. Run `go generate ./...` (with protoc and the
+// protoc-gen-go / protoc-gen-go-grpc plugins on PATH) to populate it.
+package clusterpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative -I ../../../../proto/cluster ../../../../proto/cluster/cluster.proto
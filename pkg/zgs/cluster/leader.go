@@ -0,0 +1,220 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/starlegend123/0g-storage-homework/pkg/manifest"
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs/cluster/clusterpb"
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs/pipeline"
+)
+
+// UploadFile partitions path's chunks across peers (including this node)
+// and uploads the resulting manifest once every chunk finalizes. It must
+// only be called on the current raft leader.
+func (n *Node) UploadFile(ctx context.Context, path string, chunkSize int64, peers []PeerInfo) (manifestRoot string, err error) {
+	if !n.IsLeader() {
+		return "", fmt.Errorf("只有 leader 才能发起集群上传，当前 leader 是 %s", grpcAddrFromRaft(string(n.raft.Leader())))
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("读取文件信息失败: %w", err)
+	}
+	filename := filepath.Base(path)
+	total := info.Size()
+
+	n.mu.Lock()
+	for _, p := range peers {
+		n.peers[p.ID] = p
+	}
+	n.mu.Unlock()
+
+	chunks := make([]ChunkSpec, 0)
+	for _, c := range pipeline.SplitChunks(total, chunkSize) {
+		chunks = append(chunks, ChunkSpec{Offset: c.Offset, Length: c.Length})
+	}
+	for i := range chunks {
+		chunks[i].Index = i
+	}
+
+	assignment := AssignChunks(chunks, peers)
+
+	for _, p := range peers {
+		mine := assignment[p.ID]
+		if len(mine) == 0 {
+			continue
+		}
+		if p.ID == n.cfg.ListenAddr {
+			n.uploadLocalShare(ctx, path, mine)
+			continue
+		}
+		if err := n.sendAssignment(ctx, p, filename, total, chunkSize, mine); err != nil {
+			return "", fmt.Errorf("向 peer %s 分发分片失败: %w", p.ID, err)
+		}
+	}
+
+	if err := n.waitForCompletion(ctx, len(chunks)); err != nil {
+		return "", err
+	}
+
+	return n.uploadManifest(ctx, filename, total, chunkSize, len(chunks))
+}
+
+// uploadLocalShare uploads the leader's own shard directly, without a
+// network hop, recording each outcome the same way a follower's
+// ReportStatus call would.
+func (n *Node) uploadLocalShare(ctx context.Context, path string, share []ChunkSpec) {
+	f, err := os.Open(path)
+	if err != nil {
+		for _, c := range share {
+			n.recordLocal(c.Index, pipeline.StateFailed, "", "", err)
+		}
+		return
+	}
+	defer f.Close()
+
+	for _, c := range share {
+		buf := make([]byte, c.Length)
+		if _, err := f.ReadAt(buf, c.Offset); err != nil {
+			n.recordLocal(c.Index, pipeline.StateFailed, "", "", err)
+			continue
+		}
+		sha := sha256Hex(buf)
+
+		_, root, err := n.cfg.Client.Upload(ctx, buf)
+		if err != nil {
+			n.recordLocal(c.Index, pipeline.StateFailed, "", "", err)
+			continue
+		}
+		n.recordLocal(c.Index, pipeline.StateFinalized, root, sha, nil)
+	}
+}
+
+func (n *Node) recordLocal(index int, state pipeline.ChunkState, root, sha string, cause error) {
+	msg := ""
+	if cause != nil {
+		msg = cause.Error()
+	}
+	n.mu.Lock()
+	n.assignments[index] = &clusterpb.StatusReport{
+		PeerId:     n.cfg.ListenAddr,
+		ChunkIndex: int32(index),
+		State:      string(state),
+		ZgRoot:     root,
+		Sha256:     sha,
+		Error:      msg,
+	}
+	n.mu.Unlock()
+}
+
+// sendAssignment calls AssignChunks on a follower's gRPC endpoint.
+func (n *Node) sendAssignment(ctx context.Context, p PeerInfo, filename string, total, chunkSize int64, share []ChunkSpec) error {
+	conn, err := dial(p.Address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	req := &clusterpb.AssignChunksRequest{
+		ManifestFilename: filename,
+		TotalSize:        total,
+		ChunkSize:        chunkSize,
+	}
+	for _, c := range share {
+		req.Chunks = append(req.Chunks, &clusterpb.ChunkAssignment{
+			Index:  int32(c.Index),
+			Offset: c.Offset,
+			Length: c.Length,
+		})
+	}
+
+	reply, err := clusterpb.NewClusterServiceClient(conn).AssignChunks(ctx, req)
+	if err != nil {
+		return err
+	}
+	if !reply.Accepted {
+		return fmt.Errorf("peer 拒绝了分片分配: %s", reply.Error)
+	}
+	return nil
+}
+
+// waitForCompletion polls n.assignments until every one of total chunks has
+// finalized, one has failed, or ctx is cancelled.
+func (n *Node) waitForCompletion(ctx context.Context, total int) error {
+	for {
+		n.mu.Lock()
+		done := 0
+		for _, rep := range n.assignments {
+			switch pipeline.ChunkState(rep.State) {
+			case pipeline.StateFinalized:
+				done++
+			case pipeline.StateFailed:
+				idx := rep.ChunkIndex
+				n.mu.Unlock()
+				return fmt.Errorf("第 %d 个分片上传失败: %s", idx, rep.Error)
+			}
+		}
+		n.mu.Unlock()
+
+		if done >= total {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(300 * time.Millisecond):
+		}
+	}
+}
+
+// uploadManifest assembles the manifest from every finalized chunk report
+// and uploads it, returning its root.
+func (n *Node) uploadManifest(ctx context.Context, filename string, total, chunkSize int64, numChunks int) (string, error) {
+	n.mu.Lock()
+	reports := make([]*clusterpb.StatusReport, numChunks)
+	for i := 0; i < numChunks; i++ {
+		reports[i] = n.assignments[i]
+	}
+	n.mu.Unlock()
+
+	// reports[i] is nil if no report for index i ever arrived; don't
+	// dereference it here and let the nil check below produce the real error
+	// instead.
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i] == nil || reports[j] == nil {
+			return false
+		}
+		return reports[i].ChunkIndex < reports[j].ChunkIndex
+	})
+
+	m := manifest.New(filename, total, chunkSize)
+	offset := int64(0)
+	for i, rep := range reports {
+		if rep == nil || pipeline.ChunkState(rep.State) != pipeline.StateFinalized {
+			return "", fmt.Errorf("第 %d 个分片缺少上传结果", i)
+		}
+		length := chunkSize
+		if remaining := total - offset; remaining < length {
+			length = remaining
+		}
+		m.AddChunk(manifest.Chunk{Index: i, Offset: offset, Length: length, SHA256: rep.Sha256, ZgRoot: rep.ZgRoot})
+		offset += length
+	}
+
+	encoded, err := m.Encode()
+	if err != nil {
+		return "", err
+	}
+
+	_, root, err := n.cfg.Client.Upload(ctx, encoded)
+	if err != nil {
+		return "", fmt.Errorf("上传 manifest 失败: %w", err)
+	}
+	return root, nil
+}
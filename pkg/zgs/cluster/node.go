@@ -0,0 +1,218 @@
+// Package cluster lets several zgs processes cooperate to upload one large
+// file: peers form a small cluster over gRPC, elect a leader with
+// hashicorp/raft, and the leader partitions the chunk list among followers
+// based on the shard topology indexer.GetShardedNodes reports.
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/raft"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs"
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs/cluster/clusterpb"
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs/pipeline"
+)
+
+// Config configures one cluster peer. A peer's raft transport listens one
+// port above its gRPC ListenAddr, so `--peers` only needs to name the
+// gRPC addresses.
+type Config struct {
+	ListenAddr string   // gRPC listen address, e.g. "127.0.0.1:7900"
+	Peers      []string // bootstrap gRPC addresses of the other peers, e.g. []string{"127.0.0.1:7901"}
+	RaftDir    string   // where this peer keeps its raft snapshots
+	Client     *zgs.StorageClient
+	InputPath  string // local path to the (shared) input file being uploaded
+}
+
+// Node is one zgs process participating in cluster mode.
+type Node struct {
+	clusterpb.UnimplementedClusterServiceServer
+
+	cfg  Config
+	raft *raft.Raft
+	grpc *grpc.Server
+
+	mu          sync.Mutex
+	assignments map[int]*clusterpb.StatusReport // chunk index -> latest report
+	peers       map[string]PeerInfo
+}
+
+// New starts raft and the gRPC server for one cluster peer. bootstrap
+// should be true only for the peer forming a brand-new cluster (typically
+// the one with no --peers); every other peer is expected to join that
+// cluster out of band (e.g. via the leader's raft AddVoter API).
+func New(cfg Config, bootstrap bool) (*Node, error) {
+	r, err := setupRaft(cfg.ListenAddr, raftAddr(cfg.ListenAddr), cfg.RaftDir, bootstrap)
+	if err != nil {
+		return nil, err
+	}
+
+	n := &Node{
+		cfg:         cfg,
+		raft:        r,
+		assignments: make(map[int]*clusterpb.StatusReport),
+		peers:       make(map[string]PeerInfo),
+	}
+
+	lis, err := net.Listen("tcp", cfg.ListenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("监听 gRPC 地址失败: %w", err)
+	}
+	n.grpc = grpc.NewServer()
+	clusterpb.RegisterClusterServiceServer(n.grpc, n)
+	go n.grpc.Serve(lis)
+
+	return n, nil
+}
+
+// raftAddr derives a peer's raft transport address from its gRPC address
+// by bumping the port by one.
+func raftAddr(grpcAddr string) string {
+	host, portStr, err := net.SplitHostPort(grpcAddr)
+	if err != nil {
+		return grpcAddr
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return grpcAddr
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port+1))
+}
+
+// grpcAddrFromRaft is raftAddr's inverse: it recovers a peer's gRPC
+// ClusterService address from the raft transport address n.raft.Leader()
+// reports, so code that only has the latter can still dial the former.
+func grpcAddrFromRaft(raftAddr string) string {
+	host, portStr, err := net.SplitHostPort(raftAddr)
+	if err != nil {
+		return raftAddr
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return raftAddr
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port-1))
+}
+
+// IsLeader reports whether this node currently holds raft leadership.
+func (n *Node) IsLeader() bool {
+	return n.raft.State() == raft.Leader
+}
+
+// dial opens an insecure gRPC connection to another peer's ListenAddr.
+func dial(addr string) (*grpc.ClientConn, error) {
+	return grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+// AssignChunks implements clusterpb.ClusterServiceServer: a follower
+// receives its slice of the chunk list and uploads it in the background,
+// reporting each chunk's outcome back to the leader as it finishes.
+func (n *Node) AssignChunks(ctx context.Context, req *clusterpb.AssignChunksRequest) (*clusterpb.AssignChunksReply, error) {
+	go n.runAssignment(req)
+	return &clusterpb.AssignChunksReply{Accepted: true}, nil
+}
+
+// ReportStatus implements clusterpb.ClusterServiceServer: the leader
+// records a follower's per-chunk progress for `zgs cluster status`.
+func (n *Node) ReportStatus(ctx context.Context, report *clusterpb.StatusReport) (*clusterpb.Ack, error) {
+	n.mu.Lock()
+	n.assignments[int(report.ChunkIndex)] = report
+	n.mu.Unlock()
+	return &clusterpb.Ack{}, nil
+}
+
+// GetStatus implements clusterpb.ClusterServiceServer. It is the cluster
+// analogue of the old debugShardedNodes print-out: leader, peer set,
+// per-peer chunk progress, and shard assignment.
+func (n *Node) GetStatus(ctx context.Context, _ *clusterpb.StatusRequest) (*clusterpb.ClusterStatus, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	status := &clusterpb.ClusterStatus{LeaderId: grpcAddrFromRaft(string(n.raft.Leader()))}
+	for id, p := range n.peers {
+		assigned, done := 0, 0
+		for _, rep := range n.assignments {
+			if rep.PeerId != id {
+				continue
+			}
+			assigned++
+			if rep.State == string(pipeline.StateFinalized) {
+				done++
+			}
+		}
+		status.Peers = append(status.Peers, &clusterpb.PeerStatus{
+			PeerId:         id,
+			Address:        p.Address,
+			NumShard:       int32(p.NumShard),
+			ShardId:        int32(p.ShardID),
+			ChunksAssigned: int32(assigned),
+			ChunksDone:     int32(done),
+		})
+	}
+	return status, nil
+}
+
+// runAssignment uploads every chunk in req from the local copy of the
+// input file and reports each outcome back to the current raft leader.
+func (n *Node) runAssignment(req *clusterpb.AssignChunksRequest) {
+	leaderRaftAddr := strings.TrimSpace(string(n.raft.Leader()))
+	if leaderRaftAddr == "" {
+		return
+	}
+	leaderAddr := grpcAddrFromRaft(leaderRaftAddr)
+
+	conn, err := dial(leaderAddr)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	client := clusterpb.NewClusterServiceClient(conn)
+
+	f, err := os.Open(n.cfg.InputPath)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	for _, ca := range req.Chunks {
+		buf := make([]byte, ca.Length)
+		if _, err := f.ReadAt(buf, ca.Offset); err != nil {
+			n.reportStatus(client, ca.Index, pipeline.StateFailed, "", "", err)
+			continue
+		}
+		sha := sha256Hex(buf)
+
+		_, root, err := n.cfg.Client.Upload(context.Background(), buf)
+		if err != nil {
+			n.reportStatus(client, ca.Index, pipeline.StateFailed, "", "", err)
+			continue
+		}
+		n.reportStatus(client, ca.Index, pipeline.StateFinalized, root, sha, nil)
+	}
+}
+
+func (n *Node) reportStatus(client clusterpb.ClusterServiceClient, index int32, state pipeline.ChunkState, root, sha string, cause error) {
+	msg := ""
+	if cause != nil {
+		msg = cause.Error()
+	}
+	if _, err := client.ReportStatus(context.Background(), &clusterpb.StatusReport{
+		PeerId:     n.cfg.ListenAddr,
+		ChunkIndex: index,
+		State:      string(state),
+		ZgRoot:     root,
+		Sha256:     sha,
+		Error:      msg,
+	}); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️ 向 leader 上报第 %d 个分片状态失败: %v\n", index, err)
+	}
+}
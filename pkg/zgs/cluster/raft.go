@@ -0,0 +1,66 @@
+package cluster
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/hashicorp/raft"
+)
+
+// fsm is an empty raft.FSM: cluster mode only uses raft for leader
+// election (so exactly one peer partitions and hands out chunk work), not
+// for replicating any log, so there is nothing to apply.
+type fsm struct{}
+
+func (fsm) Apply(*raft.Log) interface{} { return nil }
+
+func (fsm) Snapshot() (raft.FSMSnapshot, error) { return emptySnapshot{}, nil }
+
+func (fsm) Restore(rc io.ReadCloser) error { return rc.Close() }
+
+type emptySnapshot struct{}
+
+func (emptySnapshot) Persist(sink raft.SnapshotSink) error { return sink.Close() }
+
+func (emptySnapshot) Release() {}
+
+// setupRaft starts a raft.Raft node bound to bindAddr, keeping its
+// snapshots under dataDir. bootstrap should be true only for the first
+// peer forming a brand-new cluster; every other peer joins via the
+// existing leader's AddVoter call triggered out of band.
+func setupRaft(nodeID, bindAddr, dataDir string, bootstrap bool) (*raft.Raft, error) {
+	cfg := raft.DefaultConfig()
+	cfg.LocalID = raft.ServerID(nodeID)
+
+	if err := os.MkdirAll(dataDir, 0o755); err != nil {
+		return nil, fmt.Errorf("创建 raft 数据目录失败: %w", err)
+	}
+
+	snapshots, err := raft.NewFileSnapshotStore(dataDir, 2, os.Stderr)
+	if err != nil {
+		return nil, fmt.Errorf("创建 raft 快照存储失败: %w", err)
+	}
+
+	transport, err := raft.NewTCPTransport(bindAddr, nil, 3, 10*time.Second, os.Stderr)
+	if err != nil {
+		return nil, fmt.Errorf("创建 raft 传输层失败: %w", err)
+	}
+
+	logStore := raft.NewInmemStore()
+	stableStore := raft.NewInmemStore()
+
+	r, err := raft.NewRaft(cfg, fsm{}, logStore, stableStore, snapshots, transport)
+	if err != nil {
+		return nil, fmt.Errorf("启动 raft 节点失败: %w", err)
+	}
+
+	if bootstrap {
+		r.BootstrapCluster(raft.Configuration{
+			Servers: []raft.Server{{ID: cfg.LocalID, Address: transport.LocalAddr()}},
+		})
+	}
+
+	return r, nil
+}
@@ -0,0 +1,70 @@
+package cluster
+
+// ChunkSpec is the {index, offset, length} triple the leader hands out to
+// peers, independent of whether it originated from pipeline.ChunkRecord or
+// a wire clusterpb.ChunkAssignment.
+type ChunkSpec struct {
+	Index  int
+	Offset int64
+	Length int64
+}
+
+// PeerInfo is one cluster member as seen by the leader when assigning
+// chunks: its gRPC address plus the shard role it has been given out of
+// the indexer's NumShard/ShardId topology (see indexer.GetShardedNodes).
+type PeerInfo struct {
+	ID       string
+	Address  string
+	NumShard int
+	ShardID  int
+}
+
+// AssignChunks partitions chunks across peers, preferring to give each peer
+// the chunks whose index maps to the shard it was given (index % NumShard
+// == ShardID, mirroring how 0G storage nodes themselves shard data) so a
+// peer's uploads preferentially land on the nodes it already has to talk
+// to. Anything left over (e.g. NumShard <= 1, or no peer claims a given
+// index) is round-robined so every peer still gets a roughly even share.
+//
+// NumShard <= 1 never discriminates between peers (index % 1 == 0 == ShardID
+// for every chunk), so shard matching is skipped whenever it would dump
+// everything on a single peer and leave the rest idle.
+func AssignChunks(chunks []ChunkSpec, peers []PeerInfo) map[string][]ChunkSpec {
+	assignment := make(map[string][]ChunkSpec, len(peers))
+	for _, p := range peers {
+		assignment[p.ID] = nil
+	}
+	if len(peers) == 0 {
+		return assignment
+	}
+
+	matchedPeers := make(map[string]bool)
+	var leftover []ChunkSpec
+	for _, c := range chunks {
+		matched := false
+		for _, p := range peers {
+			if p.NumShard > 1 && c.Index%p.NumShard == p.ShardID {
+				assignment[p.ID] = append(assignment[p.ID], c)
+				matchedPeers[p.ID] = true
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			leftover = append(leftover, c)
+		}
+	}
+
+	if len(matchedPeers) <= 1 && len(leftover) < len(chunks) {
+		for id := range assignment {
+			assignment[id] = nil
+		}
+		leftover = chunks
+	}
+
+	for i, c := range leftover {
+		p := peers[i%len(peers)]
+		assignment[p.ID] = append(assignment[p.ID], c)
+	}
+	return assignment
+}
@@ -0,0 +1,96 @@
+package cluster
+
+import "testing"
+
+func totalAssigned(assignment map[string][]ChunkSpec) int {
+	n := 0
+	for _, chunks := range assignment {
+		n += len(chunks)
+	}
+	return n
+}
+
+func TestAssignChunksShardMatch(t *testing.T) {
+	chunks := make([]ChunkSpec, 6)
+	for i := range chunks {
+		chunks[i] = ChunkSpec{Index: i}
+	}
+	peers := []PeerInfo{
+		{ID: "a", NumShard: 2, ShardID: 0},
+		{ID: "b", NumShard: 2, ShardID: 1},
+	}
+
+	got := AssignChunks(chunks, peers)
+
+	if totalAssigned(got) != len(chunks) {
+		t.Fatalf("assigned %d chunks, want %d", totalAssigned(got), len(chunks))
+	}
+	for _, c := range got["a"] {
+		if c.Index%2 != 0 {
+			t.Errorf("peer a got chunk %d, which doesn't match its shard", c.Index)
+		}
+	}
+	for _, c := range got["b"] {
+		if c.Index%2 != 1 {
+			t.Errorf("peer b got chunk %d, which doesn't match its shard", c.Index)
+		}
+	}
+	if len(got["a"]) != 3 || len(got["b"]) != 3 {
+		t.Errorf("assignment = %v, want an even 3/3 split", got)
+	}
+}
+
+// TestAssignChunksNumShardOneFansOut guards against the regression fixed in
+// 94110fd: when every peer reports NumShard == 1 (a common single-shard
+// testnet topology), index%1 == 0 == ShardID for every chunk and every peer,
+// so naive shard matching would hand the entire file to whichever peer is
+// checked first and starve the rest.
+func TestAssignChunksNumShardOneFansOut(t *testing.T) {
+	chunks := make([]ChunkSpec, 9)
+	for i := range chunks {
+		chunks[i] = ChunkSpec{Index: i}
+	}
+	peers := []PeerInfo{
+		{ID: "leader", NumShard: 1, ShardID: 0},
+		{ID: "follower-1", NumShard: 1, ShardID: 0},
+		{ID: "follower-2", NumShard: 1, ShardID: 0},
+	}
+
+	got := AssignChunks(chunks, peers)
+
+	if totalAssigned(got) != len(chunks) {
+		t.Fatalf("assigned %d chunks, want %d", totalAssigned(got), len(chunks))
+	}
+	for _, p := range peers {
+		if len(got[p.ID]) == 0 {
+			t.Errorf("peer %s got no chunks, want a roughly even share", p.ID)
+		}
+	}
+}
+
+func TestAssignChunksNoPeers(t *testing.T) {
+	chunks := []ChunkSpec{{Index: 0}, {Index: 1}}
+	got := AssignChunks(chunks, nil)
+	if len(got) != 0 {
+		t.Fatalf("AssignChunks with no peers = %v, want empty", got)
+	}
+}
+
+func TestAssignChunksUnmatchedIndexRoundRobins(t *testing.T) {
+	// Shards 0 and 2 are covered; chunks at index%3==1 match no peer and
+	// must still land somewhere via the round-robin leftover path.
+	chunks := make([]ChunkSpec, 6)
+	for i := range chunks {
+		chunks[i] = ChunkSpec{Index: i}
+	}
+	peers := []PeerInfo{
+		{ID: "a", NumShard: 3, ShardID: 0},
+		{ID: "b", NumShard: 3, ShardID: 2},
+	}
+
+	got := AssignChunks(chunks, peers)
+
+	if totalAssigned(got) != len(chunks) {
+		t.Fatalf("assigned %d chunks, want %d (unmatched indices must still be distributed)", totalAssigned(got), len(chunks))
+	}
+}
@@ -0,0 +1,35 @@
+package zgs
+
+// Config 汇总了连接 0G 链 / Indexer 以及上传行为所需的全部参数。
+//
+// 除 ChunkSize 和 MaxRetries 外，每个字段都有对应的 CLI 全局 flag（见 cmd/zgs）；
+// 这两个字段只能通过 --config 指定的 TOML 文件设置。全部字段都可以从该文件
+// 整体加载，方便把一套参数固定下来反复使用，而不必每次敲一长串 flag。
+type Config struct {
+	RpcUrl      string `toml:"rpc"`
+	IndexerUrl  string `toml:"indexer"`
+	FlowAddress string `toml:"flow"`
+	TaskSize    int    `toml:"task_size"`
+	Replica     uint   `toml:"replica"`
+	KeyEnv      string `toml:"key_env"`
+	ChunkSize   int64  `toml:"chunk_size"`
+	Concurrency int    `toml:"concurrency"`
+	MaxRetries  int    `toml:"max_retries"`
+	MirrorURL   string `toml:"mirror"`
+}
+
+// DefaultConfig 返回旧 main.go 里硬编码的那套 testnet 默认值（RPC / indexer
+// 地址、TaskSize、副本数、私钥环境变量名、分片大小），外加并发数和重试次数。
+// FlowAddress 没有默认值，必须通过 --flow 或配置文件提供。
+func DefaultConfig() Config {
+	return Config{
+		RpcUrl:      "https://evmrpc-testnet.0g.ai",
+		IndexerUrl:  "https://indexer-storage-testnet-turbo.0g.ai",
+		TaskSize:    16 * 1024 * 1024, // 16MB Fragment Size
+		Replica:     1,
+		KeyEnv:      "ZGS_PRIVATE_KEY",
+		ChunkSize:   400 * 1024 * 1024, // 400MB 每个分片
+		Concurrency: 4,
+		MaxRetries:  3,
+	}
+}
@@ -0,0 +1,26 @@
+package zgs
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/BurntSushi/toml"
+)
+
+// LoadConfigFile 从磁盘读取一个 TOML 配置文件并叠加到 base 之上。
+//
+// 这里借鉴了 go-ethereum `makeConfigNode` 的思路：先构造一份带默认值的
+// Config，再用文件里出现的字段去覆盖它，未出现的字段保持默认值不变。
+func LoadConfigFile(path string, base Config) (Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return base, err
+	}
+	defer f.Close()
+
+	cfg := base
+	if _, err := toml.NewDecoder(f).Decode(&cfg); err != nil {
+		return base, fmt.Errorf("解析配置文件 %s 失败: %w", path, err)
+	}
+	return cfg, nil
+}
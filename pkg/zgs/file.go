@@ -0,0 +1,61 @@
+package zgs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/starlegend123/0g-storage-homework/pkg/zgs/pipeline"
+)
+
+// WithProgress attaches a pipeline.Progress sink that UploadFile/DownloadFile
+// report chunk/throughput updates to. Passing nil reverts to a no-op sink.
+func (c *StorageClient) WithProgress(p pipeline.Progress) *StorageClient {
+	c.progress = p
+	return c
+}
+
+// UploadFile splits path into cfg.ChunkSize pieces, uploads them through a
+// cfg.Concurrency-wide worker pool (retrying transient failures and keeping
+// a `<path>.zgsresume` checkpoint so an interrupted upload can continue),
+// and returns the manifest root tying the chunks back to one file.
+//
+// If a mirror is configured (see WithMirror), the manifest is additionally
+// mirrored under the human-readable key "manifests/<filename>" so it can be
+// found without already knowing its root.
+func (c *StorageClient) UploadFile(ctx context.Context, path string) (manifestRoot string, err error) {
+	u := pipeline.NewUploader(c,
+		pipeline.WithConcurrency(c.cfg.Concurrency),
+		pipeline.WithMaxRetries(c.cfg.MaxRetries),
+		pipeline.WithProgress(c.progressOrNop()),
+		pipeline.WithManifestHook(func(filename string, encoded []byte) {
+			if c.mirror == nil {
+				return
+			}
+			key := "manifests/" + filename
+			if err := c.mirror.Put(ctx, key, bytes.NewReader(encoded), nil); err != nil {
+				fmt.Printf("⚠️ 镜像 manifest 到 %s 失败: %v\n", key, err)
+			}
+		}),
+	)
+	return u.UploadFile(ctx, path, c.cfg.ChunkSize)
+}
+
+// DownloadFile resolves manifestRoot, fetches its chunks through the same
+// kind of worker pool as UploadFile (with a matching `<outPath>.zgsresume`
+// checkpoint), verifies each chunk's SHA-256, and reassembles outPath.
+func (c *StorageClient) DownloadFile(ctx context.Context, manifestRoot, outPath string) error {
+	d := pipeline.NewDownloader(c,
+		pipeline.WithConcurrency(c.cfg.Concurrency),
+		pipeline.WithMaxRetries(c.cfg.MaxRetries),
+		pipeline.WithProgress(c.progressOrNop()),
+	)
+	return d.DownloadFile(ctx, manifestRoot, outPath)
+}
+
+func (c *StorageClient) progressOrNop() pipeline.Progress {
+	if c.progress != nil {
+		return c.progress
+	}
+	return pipeline.NopProgress{}
+}
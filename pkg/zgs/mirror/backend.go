@@ -0,0 +1,31 @@
+// Package mirror provides an off-chain cache for 0G Storage uploads: every
+// chunk (and the manifest tying them together) can optionally also be
+// written to a local directory, S3 bucket, or GCS bucket keyed by its zg
+// root, giving users a durable cache and a migration path in/out of 0G.
+package mirror
+
+import (
+	"context"
+	"io"
+)
+
+// Backend is a key/blob store a StorageClient can mirror chunks into.
+type Backend interface {
+	Put(ctx context.Context, key string, r io.Reader, meta map[string]string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Stat(ctx context.Context, key string) (Info, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// Info is what Stat reports about a key.
+type Info struct {
+	Exists bool
+	Size   int64
+	Meta   map[string]string
+}
+
+// Walker is implemented by backends that can enumerate every key they hold.
+// `zgs sync` uses it to find mirrored chunks that are missing from 0G.
+type Walker interface {
+	Walk(ctx context.Context, fn func(key string) error) error
+}
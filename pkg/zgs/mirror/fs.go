@@ -0,0 +1,124 @@
+package mirror
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FSBackend mirrors keys as files under a base directory, with a small
+// `<key>.meta.json` sidecar holding the meta map Put was given.
+type FSBackend struct {
+	baseDir string
+}
+
+// NewFSBackend returns a Backend rooted at baseDir, creating it if needed.
+func NewFSBackend(baseDir string) (*FSBackend, error) {
+	if err := os.MkdirAll(baseDir, 0o755); err != nil {
+		return nil, fmt.Errorf("创建 mirror 目录失败: %w", err)
+	}
+	return &FSBackend{baseDir: baseDir}, nil
+}
+
+func (b *FSBackend) path(key string) string {
+	return filepath.Join(b.baseDir, filepath.FromSlash(key))
+}
+
+func (b *FSBackend) metaPath(key string) string {
+	return b.path(key) + ".meta.json"
+}
+
+// Put implements Backend.
+func (b *FSBackend) Put(ctx context.Context, key string, r io.Reader, meta map[string]string) error {
+	path := b.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("创建 mirror 目录失败: %w", err)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("写入 mirror 文件失败: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, r); err != nil {
+		return fmt.Errorf("写入 mirror 文件失败: %w", err)
+	}
+
+	if len(meta) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("编码 mirror 元数据失败: %w", err)
+	}
+	return os.WriteFile(b.metaPath(key), data, 0o644)
+}
+
+// Get implements Backend.
+func (b *FSBackend) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	f, err := os.Open(b.path(key))
+	if err != nil {
+		return nil, fmt.Errorf("读取 mirror 文件失败: %w", err)
+	}
+	return f, nil
+}
+
+// Stat implements Backend.
+func (b *FSBackend) Stat(ctx context.Context, key string) (Info, error) {
+	fi, err := os.Stat(b.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return Info{}, nil
+	}
+	if err != nil {
+		return Info{}, fmt.Errorf("查询 mirror 文件失败: %w", err)
+	}
+
+	meta, _ := b.readMeta(key)
+	return Info{Exists: true, Size: fi.Size(), Meta: meta}, nil
+}
+
+// Delete implements Backend.
+func (b *FSBackend) Delete(ctx context.Context, key string) error {
+	if err := os.Remove(b.path(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("删除 mirror 文件失败: %w", err)
+	}
+	os.Remove(b.metaPath(key))
+	return nil
+}
+
+// Walk implements Walker by recursively visiting every non-sidecar file
+// under baseDir, reporting each as a slash-separated key relative to it.
+func (b *FSBackend) Walk(ctx context.Context, fn func(key string) error) error {
+	return filepath.Walk(b.baseDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasSuffix(path, ".meta.json") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(b.baseDir, path)
+		if err != nil {
+			return err
+		}
+		return fn(filepath.ToSlash(rel))
+	})
+}
+
+func (b *FSBackend) readMeta(key string) (map[string]string, error) {
+	data, err := os.ReadFile(b.metaPath(key))
+	if err != nil {
+		return nil, err
+	}
+	var meta map[string]string
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
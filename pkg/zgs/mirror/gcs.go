@@ -0,0 +1,93 @@
+package mirror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// GCSBackend mirrors keys as objects under bucket/prefix.
+type GCSBackend struct {
+	bucket *storage.BucketHandle
+	prefix string
+}
+
+// NewGCSBackend builds a GCSBackend using application-default credentials.
+func NewGCSBackend(ctx context.Context, bucketName, prefix string) (*GCSBackend, error) {
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("创建 GCS 客户端失败: %w", err)
+	}
+	return &GCSBackend{bucket: client.Bucket(bucketName), prefix: prefix}, nil
+}
+
+func (b *GCSBackend) objectKey(key string) string {
+	return path.Join(b.prefix, key)
+}
+
+// Put implements Backend.
+func (b *GCSBackend) Put(ctx context.Context, key string, r io.Reader, meta map[string]string) error {
+	w := b.bucket.Object(b.objectKey(key)).NewWriter(ctx)
+	w.Metadata = meta
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return fmt.Errorf("写入 GCS mirror 失败: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("写入 GCS mirror 失败: %w", err)
+	}
+	return nil
+}
+
+// Get implements Backend.
+func (b *GCSBackend) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	r, err := b.bucket.Object(b.objectKey(key)).NewReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("读取 GCS mirror 失败: %w", err)
+	}
+	return r, nil
+}
+
+// Stat implements Backend.
+func (b *GCSBackend) Stat(ctx context.Context, key string) (Info, error) {
+	attrs, err := b.bucket.Object(b.objectKey(key)).Attrs(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return Info{}, nil
+	}
+	if err != nil {
+		return Info{}, fmt.Errorf("查询 GCS mirror 失败: %w", err)
+	}
+	return Info{Exists: true, Size: attrs.Size, Meta: attrs.Metadata}, nil
+}
+
+// Delete implements Backend.
+func (b *GCSBackend) Delete(ctx context.Context, key string) error {
+	if err := b.bucket.Object(b.objectKey(key)).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return fmt.Errorf("删除 GCS mirror 对象失败: %w", err)
+	}
+	return nil
+}
+
+// Walk implements Walker, listing every object under bucket/prefix.
+func (b *GCSBackend) Walk(ctx context.Context, fn func(key string) error) error {
+	it := b.bucket.Objects(ctx, &storage.Query{Prefix: b.prefix})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("遍历 GCS mirror 失败: %w", err)
+		}
+		rel := strings.TrimPrefix(attrs.Name, b.prefix+"/")
+		if err := fn(rel); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package mirror
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ParseURL builds a Backend from a `--mirror` style URL:
+//
+//	file:///path            -> FSBackend rooted at /path
+//	s3://bucket/prefix      -> S3Backend
+//	gs://bucket/prefix      -> GCSBackend
+func ParseURL(ctx context.Context, raw string) (Backend, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("解析 mirror 地址失败: %w", err)
+	}
+
+	switch u.Scheme {
+	case "file":
+		return NewFSBackend(u.Path)
+	case "s3":
+		return NewS3Backend(ctx, u.Host, strings.TrimPrefix(u.Path, "/"))
+	case "gs":
+		return NewGCSBackend(ctx, u.Host, strings.TrimPrefix(u.Path, "/"))
+	default:
+		return nil, fmt.Errorf("不支持的 mirror scheme: %q (支持 file/s3/gs)", u.Scheme)
+	}
+}
@@ -0,0 +1,121 @@
+package mirror
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// S3Backend mirrors keys as objects under bucket/prefix.
+type S3Backend struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+// NewS3Backend builds an S3Backend using the default AWS credential chain.
+func NewS3Backend(ctx context.Context, bucket, prefix string) (*S3Backend, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("加载 AWS 配置失败: %w", err)
+	}
+	return &S3Backend{client: s3.NewFromConfig(cfg), bucket: bucket, prefix: prefix}, nil
+}
+
+func (b *S3Backend) objectKey(key string) string {
+	return path.Join(b.prefix, key)
+}
+
+// Put implements Backend.
+func (b *S3Backend) Put(ctx context.Context, key string, r io.Reader, meta map[string]string) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("读取上传内容失败: %w", err)
+	}
+
+	_, err = b.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:   aws.String(b.bucket),
+		Key:      aws.String(b.objectKey(key)),
+		Body:     bytes.NewReader(data),
+		Metadata: meta,
+	})
+	if err != nil {
+		return fmt.Errorf("写入 S3 mirror 失败: %w", err)
+	}
+	return nil
+}
+
+// Get implements Backend.
+func (b *S3Backend) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	out, err := b.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.objectKey(key)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("读取 S3 mirror 失败: %w", err)
+	}
+	return out.Body, nil
+}
+
+// Stat implements Backend.
+func (b *S3Backend) Stat(ctx context.Context, key string) (Info, error) {
+	out, err := b.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.objectKey(key)),
+	})
+	var notFound *types.NotFound
+	if errors.As(err, &notFound) {
+		return Info{}, nil
+	}
+	if err != nil {
+		return Info{}, fmt.Errorf("查询 S3 mirror 失败: %w", err)
+	}
+
+	size := int64(0)
+	if out.ContentLength != nil {
+		size = *out.ContentLength
+	}
+	return Info{Exists: true, Size: size, Meta: out.Metadata}, nil
+}
+
+// Delete implements Backend.
+func (b *S3Backend) Delete(ctx context.Context, key string) error {
+	_, err := b.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.objectKey(key)),
+	})
+	if err != nil {
+		return fmt.Errorf("删除 S3 mirror 对象失败: %w", err)
+	}
+	return nil
+}
+
+// Walk implements Walker, listing every object under bucket/prefix.
+func (b *S3Backend) Walk(ctx context.Context, fn func(key string) error) error {
+	paginator := s3.NewListObjectsV2Paginator(b.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(b.bucket),
+		Prefix: aws.String(b.prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("遍历 S3 mirror 失败: %w", err)
+		}
+		for _, obj := range page.Contents {
+			rel := strings.TrimPrefix(aws.ToString(obj.Key), b.prefix+"/")
+			if err := fn(rel); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ChunkState tracks where one chunk is in the upload/download lifecycle so a
+// restart can skip finished work.
+type ChunkState string
+
+const (
+	StatePending   ChunkState = "pending"
+	StateFinalized ChunkState = "finalized"
+	StateFailed    ChunkState = "failed"
+)
+
+// ChunkRecord is the on-disk record for a single chunk.
+type ChunkRecord struct {
+	Offset int64      `json:"offset"`
+	Length int64      `json:"length"`
+	SHA256 string     `json:"sha256"`
+	Root   string     `json:"root"`
+	TxHash string     `json:"tx_hash,omitempty"`
+	State  ChunkState `json:"state"`
+}
+
+// Checkpoint is the `<input>.zgsresume` file: one JSON document recording
+// the state of every chunk of a single upload or download.
+type Checkpoint struct {
+	path     string
+	Filename string        `json:"filename"`
+	Total    int64         `json:"total_size"`
+	Chunks   []ChunkRecord `json:"chunks"`
+}
+
+// NewCheckpoint builds an in-memory checkpoint over chunks, keeping each
+// record's State as given; callers persist it with Save once the chunk list
+// is final.
+func NewCheckpoint(path, filename string, total int64, chunks []ChunkRecord) *Checkpoint {
+	return &Checkpoint{path: path, Filename: filename, Total: total, Chunks: chunks}
+}
+
+// LoadCheckpoint reads an existing resume file, or returns (nil, nil) if it
+// does not exist yet so callers can fall back to starting fresh.
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取 resume 文件失败: %w", err)
+	}
+
+	var cp Checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("解析 resume 文件失败: %w", err)
+	}
+	cp.path = path
+	return &cp, nil
+}
+
+// Save writes the checkpoint back to disk.
+func (cp *Checkpoint) Save() error {
+	data, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return fmt.Errorf("编码 resume 文件失败: %w", err)
+	}
+	if err := os.WriteFile(cp.path, data, 0o644); err != nil {
+		return fmt.Errorf("写入 resume 文件失败: %w", err)
+	}
+	return nil
+}
+
+// Remove deletes the checkpoint file, typically called once a transfer
+// finishes successfully and no longer needs to be resumable.
+func (cp *Checkpoint) Remove() error {
+	if err := os.Remove(cp.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package pipeline
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/starlegend123/0g-storage-homework/pkg/manifest"
+)
+
+// Downloader resolves a manifest root and fetches its chunks through a
+// bounded worker pool, resuming from a `<outPath>.zgsresume` checkpoint.
+type Downloader struct {
+	client ChunkClient
+	opts   options
+}
+
+// NewDownloader builds a Downloader around client.
+func NewDownloader(client ChunkClient, opts ...Option) *Downloader {
+	o := defaultOptions()
+	for _, apply := range opts {
+		apply(&o)
+	}
+	return &Downloader{client: client, opts: o}
+}
+
+// DownloadFile resolves manifestRoot, fetches every chunk (skipping ones a
+// previous run already finalized) and reassembles outPath.
+func (d *Downloader) DownloadFile(ctx context.Context, manifestRoot, outPath string) error {
+	m, err := d.fetchManifest(ctx, manifestRoot)
+	if err != nil {
+		return err
+	}
+
+	cpPath := outPath + ".zgsresume"
+	cp, resumed, err := loadOrInitDownloadCheckpoint(cpPath, m)
+	if err != nil {
+		return err
+	}
+
+	// A resumed checkpoint already has finalized chunks written to outPath;
+	// os.Create would truncate the file and zero out their byte ranges.
+	flags := os.O_WRONLY | os.O_CREATE
+	if !resumed {
+		flags |= os.O_TRUNC
+	}
+	out, err := os.OpenFile(outPath, flags, 0o644)
+	if err != nil {
+		return fmt.Errorf("创建输出文件失败: %w", err)
+	}
+	defer out.Close()
+
+	if err := d.downloadPending(ctx, out, cp); err != nil {
+		return err
+	}
+
+	return cp.Remove()
+}
+
+// fetchManifest downloads and decodes the manifest blob itself.
+func (d *Downloader) fetchManifest(ctx context.Context, manifestRoot string) (*manifest.Manifest, error) {
+	tmp, err := os.CreateTemp("", "zgs-manifest-*")
+	if err != nil {
+		return nil, fmt.Errorf("创建临时文件失败: %w", err)
+	}
+	tmpPath := tmp.Name()
+	tmp.Close()
+	defer os.Remove(tmpPath)
+
+	if err := d.client.Download(ctx, manifestRoot, tmpPath); err != nil {
+		return nil, fmt.Errorf("下载 manifest 失败: %w", err)
+	}
+
+	data, err := os.ReadFile(tmpPath)
+	if err != nil {
+		return nil, fmt.Errorf("读取 manifest 失败: %w", err)
+	}
+
+	return manifest.Decode(data)
+}
+
+// loadOrInitDownloadCheckpoint reuses an existing resume file if it matches
+// the manifest being downloaded, otherwise builds a fresh one. The second
+// return value reports whether an existing checkpoint was reused, so the
+// caller knows whether outPath already holds finalized chunk data.
+func loadOrInitDownloadCheckpoint(cpPath string, m *manifest.Manifest) (*Checkpoint, bool, error) {
+	cp, err := LoadCheckpoint(cpPath)
+	if err != nil {
+		return nil, false, err
+	}
+	if cp != nil && cp.Filename == m.Filename && cp.Total == m.TotalSize && len(cp.Chunks) == len(m.Chunks) {
+		return cp, true, nil
+	}
+
+	chunks := make([]ChunkRecord, len(m.Chunks))
+	for i, c := range m.Chunks {
+		chunks[i] = ChunkRecord{
+			Offset: c.Offset,
+			Length: c.Length,
+			SHA256: c.SHA256,
+			Root:   c.ZgRoot,
+			State:  StatePending,
+		}
+	}
+
+	cp = NewCheckpoint(cpPath, m.Filename, m.TotalSize, chunks)
+	if err := cp.Save(); err != nil {
+		return nil, false, err
+	}
+	return cp, false, nil
+}
+
+// downloadPending runs the worker pool over every chunk that isn't already
+// StateFinalized, writing each into out at its recorded offset.
+func (d *Downloader) downloadPending(ctx context.Context, out io.WriterAt, cp *Checkpoint) error {
+	total := int64(0)
+	for _, c := range cp.Chunks {
+		total += c.Length
+	}
+
+	var (
+		mu       sync.Mutex
+		done     int64
+		bytes    int64
+		start    = time.Now()
+		firstErr error
+	)
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < d.opts.concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indices {
+				if err := d.downloadOne(ctx, out, cp, idx, &mu); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+					continue
+				}
+
+				mu.Lock()
+				done++
+				bytes += cp.Chunks[idx].Length
+				elapsed := time.Since(start).Seconds()
+				throughput := 0.0
+				if elapsed > 0 {
+					throughput = float64(bytes) / elapsed
+				}
+				d.opts.progress.Report(Stats{
+					BytesDone:     bytes,
+					BytesTotal:    total,
+					ChunksDone:    int(done),
+					ChunksTotal:   len(cp.Chunks),
+					ThroughputBps: throughput,
+				})
+				if err := cp.Save(); err != nil && firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for idx, c := range cp.Chunks {
+		if c.State == StateFinalized {
+			continue
+		}
+		indices <- idx
+	}
+	close(indices)
+	wg.Wait()
+
+	return firstErr
+}
+
+// downloadOne fetches a single pending chunk with retries, verifies its
+// SHA-256 and writes it into out at the chunk's offset. mu must be the same
+// mutex the caller takes around cp.Save(), since cp.Chunks is marshalled
+// there from another goroutine while this one is still writing to it.
+func (d *Downloader) downloadOne(ctx context.Context, out io.WriterAt, cp *Checkpoint, idx int, mu *sync.Mutex) error {
+	mu.Lock()
+	rec := cp.Chunks[idx]
+	mu.Unlock()
+
+	tmp, err := os.CreateTemp("", "zgs-chunk-*")
+	if err != nil {
+		return fmt.Errorf("创建临时文件失败: %w", err)
+	}
+	tmpPath := tmp.Name()
+	tmp.Close()
+	defer os.Remove(tmpPath)
+
+	err = withRetry(ctx, d.opts.maxRetries, func() error {
+		return d.client.Download(ctx, rec.Root, tmpPath)
+	})
+	if err != nil {
+		mu.Lock()
+		cp.Chunks[idx].State = StateFailed
+		mu.Unlock()
+		return fmt.Errorf("下载第 %d 个分片失败: %w", idx, err)
+	}
+
+	data, err := os.ReadFile(tmpPath)
+	if err != nil {
+		return fmt.Errorf("读取第 %d 个分片失败: %w", idx, err)
+	}
+
+	sum := sha256.Sum256(data)
+	if got := hex.EncodeToString(sum[:]); got != rec.SHA256 {
+		mu.Lock()
+		cp.Chunks[idx].State = StateFailed
+		mu.Unlock()
+		return fmt.Errorf("第 %d 个分片校验失败: 期望 sha256=%s, 实际=%s", idx, rec.SHA256, got)
+	}
+
+	if _, err := out.WriteAt(data, rec.Offset); err != nil {
+		return fmt.Errorf("写入第 %d 个分片失败: %w", idx, err)
+	}
+
+	mu.Lock()
+	cp.Chunks[idx].State = StateFinalized
+	mu.Unlock()
+	return nil
+}
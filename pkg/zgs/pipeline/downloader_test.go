@@ -0,0 +1,92 @@
+package pipeline
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+// fakeChunkClient is a ChunkClient that serves canned chunk bodies by root
+// and records which roots it was asked to fetch.
+type fakeChunkClient struct {
+	data map[string][]byte
+
+	mu    sync.Mutex
+	calls []string
+}
+
+func (f *fakeChunkClient) Upload(ctx context.Context, data []byte) (string, string, error) {
+	return "", "", nil
+}
+
+func (f *fakeChunkClient) Download(ctx context.Context, root, outFile string) error {
+	f.mu.Lock()
+	f.calls = append(f.calls, root)
+	f.mu.Unlock()
+
+	body, ok := f.data[root]
+	if !ok {
+		return fmt.Errorf("fakeChunkClient: no data registered for root %s", root)
+	}
+	return os.WriteFile(outFile, body, 0o644)
+}
+
+// memWriterAt is an in-memory io.WriterAt for assembling downloaded chunks.
+type memWriterAt struct {
+	mu   sync.Mutex
+	data []byte
+}
+
+func (m *memWriterAt) WriteAt(p []byte, off int64) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if end := int(off) + len(p); end > len(m.data) {
+		grown := make([]byte, end)
+		copy(grown, m.data)
+		m.data = grown
+	}
+	copy(m.data[off:], p)
+	return len(p), nil
+}
+
+func TestDownloadPendingSkipsFinalizedChunks(t *testing.T) {
+	pending1 := []byte("hello-chunk-one")
+	pending2 := []byte("hello-chunk-two")
+	sum1 := sha256.Sum256(pending1)
+	sum2 := sha256.Sum256(pending2)
+
+	cp := NewCheckpoint(filepath.Join(t.TempDir(), "out.zgsresume"), "out.bin", 0, []ChunkRecord{
+		{Offset: 0, Length: 5, Root: "root-0", SHA256: "already-finalized", State: StateFinalized},
+		{Offset: 5, Length: int64(len(pending1)), Root: "root-1", SHA256: hex.EncodeToString(sum1[:]), State: StatePending},
+		{Offset: 5 + int64(len(pending1)), Length: int64(len(pending2)), Root: "root-2", SHA256: hex.EncodeToString(sum2[:]), State: StatePending},
+	})
+
+	client := &fakeChunkClient{data: map[string][]byte{
+		"root-1": pending1,
+		"root-2": pending2,
+	}}
+	d := NewDownloader(client)
+
+	out := &memWriterAt{}
+	if err := d.downloadPending(context.Background(), out, cp); err != nil {
+		t.Fatalf("downloadPending() error = %v", err)
+	}
+
+	if len(client.calls) != 2 {
+		t.Fatalf("Download called %d times, want 2 (the already-finalized chunk must be skipped); calls=%v", len(client.calls), client.calls)
+	}
+	for _, root := range client.calls {
+		if root == "root-0" {
+			t.Fatal("downloadPending re-fetched a StateFinalized chunk")
+		}
+	}
+
+	if cp.Chunks[1].State != StateFinalized || cp.Chunks[2].State != StateFinalized {
+		t.Fatalf("pending chunks were not marked finalized: %+v", cp.Chunks)
+	}
+}
@@ -0,0 +1,57 @@
+package pipeline
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// Stats is a snapshot of upload/download progress handed to a Progress
+// implementation after every chunk completes.
+type Stats struct {
+	BytesDone     int64
+	BytesTotal    int64
+	ChunksDone    int
+	ChunksTotal   int
+	ThroughputBps float64
+}
+
+// Progress receives Stats updates. CLI code can use it to render a progress
+// bar; library users can plug in e.g. Prometheus counters instead.
+type Progress interface {
+	Report(Stats)
+}
+
+// NopProgress discards every update. It is the default when no Progress is
+// configured.
+type NopProgress struct{}
+
+// Report implements Progress.
+func (NopProgress) Report(Stats) {}
+
+// ConsoleProgress writes a single updating line to w, suitable for a
+// terminal. It is safe for concurrent use by multiple pipeline workers.
+type ConsoleProgress struct {
+	w     io.Writer
+	mu    sync.Mutex
+	start time.Time
+}
+
+// NewConsoleProgress returns a ConsoleProgress that writes to w.
+func NewConsoleProgress(w io.Writer) *ConsoleProgress {
+	return &ConsoleProgress{w: w, start: time.Now()}
+}
+
+// Report implements Progress.
+func (p *ConsoleProgress) Report(s Stats) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fmt.Fprintf(p.w, "\r进度: %d/%d 分片, %.1f MB/%.1f MB, %.2f MB/s",
+		s.ChunksDone, s.ChunksTotal,
+		float64(s.BytesDone)/1024/1024, float64(s.BytesTotal)/1024/1024,
+		s.ThroughputBps/1024/1024)
+	if s.ChunksDone >= s.ChunksTotal {
+		fmt.Fprintln(p.w)
+	}
+}
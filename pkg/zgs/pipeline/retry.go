@@ -0,0 +1,33 @@
+package pipeline
+
+import (
+	"context"
+	"time"
+)
+
+// withRetry runs fn up to maxAttempts times, waiting an exponentially
+// growing backoff between attempts. It returns the last error if every
+// attempt fails, or nil as soon as one succeeds.
+func withRetry(ctx context.Context, maxAttempts int, fn func() error) error {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	backoff := 500 * time.Millisecond
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		if attempt == maxAttempts-1 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+	return err
+}
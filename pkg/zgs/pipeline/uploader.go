@@ -0,0 +1,313 @@
+// Package pipeline provides a parallel, resumable chunked uploader and
+// downloader on top of a plain single-chunk client, plus progress reporting
+// and a JSON checkpoint file so an interrupted multi-gigabyte transfer can
+// continue instead of restarting from scratch.
+package pipeline
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/starlegend123/0g-storage-homework/pkg/manifest"
+)
+
+// ChunkClient is the minimal surface pipeline needs from a storage client;
+// *zgs.StorageClient satisfies it.
+type ChunkClient interface {
+	Upload(ctx context.Context, data []byte) (txHash, root string, err error)
+	Download(ctx context.Context, root, outFile string) error
+}
+
+// Option configures an Uploader or Downloader.
+type Option func(*options)
+
+type options struct {
+	concurrency  int
+	maxRetries   int
+	progress     Progress
+	manifestHook func(filename string, encoded []byte)
+}
+
+func defaultOptions() options {
+	return options{concurrency: 4, maxRetries: 3, progress: NopProgress{}}
+}
+
+// WithConcurrency bounds how many chunks are in flight at once.
+func WithConcurrency(n int) Option {
+	return func(o *options) {
+		if n > 0 {
+			o.concurrency = n
+		}
+	}
+}
+
+// WithMaxRetries sets how many attempts a chunk transfer gets before it is
+// marked StateFailed.
+func WithMaxRetries(n int) Option {
+	return func(o *options) {
+		if n > 0 {
+			o.maxRetries = n
+		}
+	}
+}
+
+// WithProgress registers a Progress sink for throughput/chunk updates.
+func WithProgress(p Progress) Option {
+	return func(o *options) {
+		if p != nil {
+			o.progress = p
+		}
+	}
+}
+
+// WithManifestHook registers a callback invoked with the encoded manifest
+// right before it is uploaded, so a caller can additionally mirror it
+// somewhere discoverable (e.g. under a human-readable key) without the
+// pipeline package needing to know anything about mirroring.
+func WithManifestHook(fn func(filename string, encoded []byte)) Option {
+	return func(o *options) {
+		o.manifestHook = fn
+	}
+}
+
+// Uploader splits a file into chunks and uploads them through a bounded
+// worker pool, resuming from a `<path>.zgsresume` checkpoint when present.
+type Uploader struct {
+	client ChunkClient
+	opts   options
+}
+
+// NewUploader builds an Uploader around client.
+func NewUploader(client ChunkClient, opts ...Option) *Uploader {
+	o := defaultOptions()
+	for _, apply := range opts {
+		apply(&o)
+	}
+	return &Uploader{client: client, opts: o}
+}
+
+// UploadFile uploads path in chunkSize pieces and returns the manifest root
+// tying them back together. Progress is reported as chunks finalize, and a
+// `<path>.zgsresume` file is kept up to date so a failed run can be retried
+// without re-uploading already-finalized chunks.
+func (u *Uploader) UploadFile(ctx context.Context, path string, chunkSize int64) (manifestRoot string, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("打开文件失败: %w", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return "", fmt.Errorf("读取文件信息失败: %w", err)
+	}
+
+	cpPath := path + ".zgsresume"
+	cp, err := loadOrInitUploadCheckpoint(cpPath, filepath.Base(path), info.Size(), chunkSize)
+	if err != nil {
+		return "", err
+	}
+
+	if err := u.uploadPending(ctx, f, cp); err != nil {
+		return "", err
+	}
+
+	root, err := u.uploadManifest(ctx, filepath.Base(path), info.Size(), chunkSize, cp)
+	if err != nil {
+		return "", err
+	}
+
+	if err := cp.Remove(); err != nil {
+		return "", fmt.Errorf("清理 resume 文件失败: %w", err)
+	}
+	return root, nil
+}
+
+// SplitChunks computes the {offset, length} boundaries of total bytes split
+// into chunkSize pieces, each initially StatePending. Shared by the
+// single-process pipeline.Uploader and pkg/zgs/cluster's leader-side
+// assignment logic so both split a file identically.
+func SplitChunks(total, chunkSize int64) []ChunkRecord {
+	var chunks []ChunkRecord
+	for offset := int64(0); offset < total; offset += chunkSize {
+		length := chunkSize
+		if remaining := total - offset; remaining < length {
+			length = remaining
+		}
+		chunks = append(chunks, ChunkRecord{Offset: offset, Length: length, State: StatePending})
+	}
+	return chunks
+}
+
+// loadOrInitUploadCheckpoint reuses an existing resume file if it matches
+// the file being uploaded, otherwise builds a fresh one with every chunk
+// pending.
+func loadOrInitUploadCheckpoint(cpPath, filename string, total, chunkSize int64) (*Checkpoint, error) {
+	cp, err := LoadCheckpoint(cpPath)
+	if err != nil {
+		return nil, err
+	}
+	if cp != nil && cp.Filename == filename && cp.Total == total {
+		return cp, nil
+	}
+
+	cp = NewCheckpoint(cpPath, filename, total, SplitChunks(total, chunkSize))
+	if err := cp.Save(); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+// uploadPending runs the worker pool over every chunk that isn't already
+// StateFinalized.
+func (u *Uploader) uploadPending(ctx context.Context, r io.ReaderAt, cp *Checkpoint) error {
+	total := int64(0)
+	for _, c := range cp.Chunks {
+		total += c.Length
+	}
+
+	var (
+		mu       sync.Mutex
+		done     int64
+		bytes    int64
+		start    = time.Now()
+		firstErr error
+	)
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < u.opts.concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indices {
+				if err := u.uploadOne(ctx, r, cp, idx, &mu); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+					continue
+				}
+
+				mu.Lock()
+				done++
+				bytes += cp.Chunks[idx].Length
+				elapsed := time.Since(start).Seconds()
+				throughput := 0.0
+				if elapsed > 0 {
+					throughput = float64(bytes) / elapsed
+				}
+				u.opts.progress.Report(Stats{
+					BytesDone:     bytes,
+					BytesTotal:    total,
+					ChunksDone:    int(done),
+					ChunksTotal:   len(cp.Chunks),
+					ThroughputBps: throughput,
+				})
+				if err := cp.Save(); err != nil && firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for idx, c := range cp.Chunks {
+		if c.State == StateFinalized {
+			continue
+		}
+		indices <- idx
+	}
+	close(indices)
+	wg.Wait()
+
+	return firstErr
+}
+
+// uploadOne uploads a single pending chunk with retries, updating its
+// checkpoint record in place. mu must be the same mutex the caller takes
+// around cp.Save(), since cp.Chunks is marshalled there from another
+// goroutine while this one is still writing to it.
+func (u *Uploader) uploadOne(ctx context.Context, r io.ReaderAt, cp *Checkpoint, idx int, mu *sync.Mutex) error {
+	mu.Lock()
+	rec := cp.Chunks[idx]
+	mu.Unlock()
+
+	buf := make([]byte, rec.Length)
+	if _, err := r.ReadAt(buf, rec.Offset); err != nil && err != io.EOF {
+		return fmt.Errorf("读取第 %d 个分片失败: %w", idx, err)
+	}
+	sum := sha256.Sum256(buf)
+	sha := hex.EncodeToString(sum[:])
+
+	var txHash, root string
+	err := withRetry(ctx, u.opts.maxRetries, func() error {
+		var uploadErr error
+		txHash, root, uploadErr = u.client.Upload(ctx, buf)
+		return uploadErr
+	})
+	if err != nil {
+		mu.Lock()
+		cp.Chunks[idx].State = StateFailed
+		mu.Unlock()
+		return fmt.Errorf("上传第 %d 个分片失败: %w", idx, err)
+	}
+
+	mu.Lock()
+	cp.Chunks[idx] = ChunkRecord{
+		Offset: rec.Offset,
+		Length: rec.Length,
+		SHA256: sha,
+		Root:   root,
+		TxHash: txHash,
+		State:  StateFinalized,
+	}
+	mu.Unlock()
+	return nil
+}
+
+// uploadManifest builds the manifest from finalized chunk records and
+// uploads it, returning its root.
+func (u *Uploader) uploadManifest(ctx context.Context, filename string, total, chunkSize int64, cp *Checkpoint) (string, error) {
+	m := manifest.New(filename, total, chunkSize)
+	for i, c := range cp.Chunks {
+		if c.State != StateFinalized {
+			return "", fmt.Errorf("第 %d 个分片未完成上传 (state=%s)", i, c.State)
+		}
+		m.AddChunk(manifest.Chunk{
+			Index:  i,
+			Offset: c.Offset,
+			Length: c.Length,
+			SHA256: c.SHA256,
+			ZgRoot: c.Root,
+		})
+	}
+
+	encoded, err := m.Encode()
+	if err != nil {
+		return "", err
+	}
+
+	if u.opts.manifestHook != nil {
+		u.opts.manifestHook(filename, encoded)
+	}
+
+	var root string
+	err = withRetry(ctx, u.opts.maxRetries, func() error {
+		var uploadErr error
+		_, root, uploadErr = u.client.Upload(ctx, encoded)
+		return uploadErr
+	})
+	if err != nil {
+		return "", fmt.Errorf("上传 manifest 失败: %w", err)
+	}
+	return root, nil
+}
@@ -0,0 +1,60 @@
+package pipeline
+
+import "testing"
+
+func TestSplitChunks(t *testing.T) {
+	cases := []struct {
+		name      string
+		total     int64
+		chunkSize int64
+		want      []ChunkRecord
+	}{
+		{
+			name:      "exact division",
+			total:     20,
+			chunkSize: 10,
+			want: []ChunkRecord{
+				{Offset: 0, Length: 10, State: StatePending},
+				{Offset: 10, Length: 10, State: StatePending},
+			},
+		},
+		{
+			name:      "remainder",
+			total:     25,
+			chunkSize: 10,
+			want: []ChunkRecord{
+				{Offset: 0, Length: 10, State: StatePending},
+				{Offset: 10, Length: 10, State: StatePending},
+				{Offset: 20, Length: 5, State: StatePending},
+			},
+		},
+		{
+			name:      "chunk size larger than total",
+			total:     3,
+			chunkSize: 10,
+			want: []ChunkRecord{
+				{Offset: 0, Length: 3, State: StatePending},
+			},
+		},
+		{
+			name:      "empty file",
+			total:     0,
+			chunkSize: 10,
+			want:      nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := SplitChunks(tc.total, tc.chunkSize)
+			if len(got) != len(tc.want) {
+				t.Fatalf("SplitChunks(%d, %d) = %d chunks, want %d: %+v", tc.total, tc.chunkSize, len(got), len(tc.want), got)
+			}
+			for i := range tc.want {
+				if got[i] != tc.want[i] {
+					t.Errorf("chunk %d = %+v, want %+v", i, got[i], tc.want[i])
+				}
+			}
+		})
+	}
+}